@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"github.com/NubeIO/rubix-rx/server/database"
@@ -51,16 +52,26 @@ type ORM interface {
 	BulkCreate(models []interface{}) []*response.ErrorResponse
 	BulkUpdate(models []interface{}, uuids []string, where *WhereClause) []*response.ErrorResponse
 	BulkDelete(modelType any, uuids []string, where *WhereClause) []*response.ErrorResponse
+	BulkCreateAny(models []interface{}, opts BulkOptions) *BulkResult
+	BulkUpdateAny(models []interface{}, uuids []string, where *WhereClause, opts BulkOptions) *BulkResult
+	BulkDeleteAny(modelType any, uuids []string, where *WhereClause, opts BulkOptions) *BulkResult
 	GetDB() *gorm.DB
 	Migrate(models ...interface{}) error
+	GetAllCursor(models any, cursor string, pageSize int, where *WhereClause) (*CursorResult, *response.ErrorResponse)
+	Transaction(fn func(tx ORM) error) *response.ErrorResponse
+	WithTx(tx *gorm.DB) ORM
+	Restore(model any, where *WhereClause) (int64, *response.ErrorResponse)
+	PublishList(model any, where *WhereClause, pub ListPublisher, opts PublishOptions) error
 }
 
 var resp *response.ErrorResponse
 
 type orm struct {
-	DB       *gorm.DB
-	resp     *response.ErrorResponse
-	validate *validator.Validate
+	DB           *gorm.DB
+	resp         *response.ErrorResponse
+	validate     *validator.Validate
+	cursorSecret []byte
+	tx           *gorm.DB // set by WithTx/Transaction; db() prefers this over DB when non-nil
 }
 
 func New(c *database.DBConfig, resp *response.ErrorResponse) ORM {
@@ -69,10 +80,16 @@ func New(c *database.DBConfig, resp *response.ErrorResponse) ORM {
 		panic(err) // Consider returning an error instead
 	}
 
+	cursorSecret := make([]byte, 32)
+	if _, err := rand.Read(cursorSecret); err != nil {
+		panic(err)
+	}
+
 	return &orm{
-		DB:       db,
-		resp:     resp,
-		validate: validator.New(),
+		DB:           db,
+		resp:         resp,
+		validate:     validator.New(),
+		cursorSecret: cursorSecret,
 	}
 }
 
@@ -81,7 +98,7 @@ func GetErrorResponse() *response.ErrorResponse {
 }
 
 func (o *orm) Get(model any, where *WhereClause, preload []string) *response.ErrorResponse {
-	query := o.DB
+	query := o.db()
 	for _, preloadAssociation := range preload {
 		query = query.Preload(preloadAssociation)
 	}
@@ -90,17 +107,24 @@ func (o *orm) Get(model any, where *WhereClause, preload []string) *response.Err
 		return o.resp.New(gorm.ErrMissingWhereClause, model)
 	}
 
+	if len(where.Select) > 0 {
+		query = query.Select(where.Select)
+	}
+	query = applyPreloads(query, where.Preloads)
+	query = applyTrashedScope(query, model, where)
+
 	result := query.Where(where.Query, where.Args...).First(model)
 	return o.resp.New(result.Error, model)
 }
 
 func (o *orm) All(models any, where *WhereClause) *response.ErrorResponse {
-	query := o.DB
+	query := o.db()
 	if where != nil {
-		preload := where.Preload
-		for _, preloadAssociation := range preload {
-			query = query.Preload(preloadAssociation)
+		if len(where.Select) > 0 {
+			query = query.Select(where.Select)
 		}
+		query = applyPreloads(query, where.Preloads)
+		query = applyTrashedScope(query, models, where)
 
 		if where.Limit > 0 {
 			query = query.Limit(where.Limit)
@@ -109,14 +133,12 @@ func (o *orm) All(models any, where *WhereClause) *response.ErrorResponse {
 			query = query.Offset(where.Offset)
 		}
 
-		if where.OrderByASC != "" {
-			// Handle ordering based on the orderBy parameter
-			query = query.Order(fmt.Sprintf("%s ASC", where.OrderByASC))
+		order, err := orderClause(models, where)
+		if err != nil {
+			return o.resp.New(err, models)
 		}
-
-		if where.OrderByDESC != "" {
-			// Handle ordering based on the orderBy parameter
-			query = query.Order(fmt.Sprintf("%s DESC", where.OrderByDESC))
+		if order != "" {
+			query = query.Order(order)
 		}
 
 		result := query.Where(where.Query, where.Args...).Find(models)
@@ -129,8 +151,9 @@ func (o *orm) All(models any, where *WhereClause) *response.ErrorResponse {
 
 func (o *orm) GetAllPaginated(models any, page int, pageSize int, where *WhereClause) (*PaginatedResult, *response.ErrorResponse) {
 	var count int64
-	db := o.DB.Model(models)
+	db := o.db().Model(models)
 	if where != nil {
+		db = applyTrashedScope(db, models, where)
 		db = db.Where(where.Query, where.Args...)
 	}
 	err := db.Count(&count).Error
@@ -143,13 +166,19 @@ func (o *orm) GetAllPaginated(models any, page int, pageSize int, where *WhereCl
 		totalPages++
 	}
 
-	offset := (page - 1) * pageSize
 	if where != nil {
-		err = db.Offset(offset).Limit(pageSize).Find(models).Error
-	} else {
-		err = db.Offset(offset).Limit(pageSize).Find(models).Error
+		order, orderErr := orderClause(models, where)
+		if orderErr != nil {
+			return nil, o.resp.New(orderErr, models)
+		}
+		if order != "" {
+			db = db.Order(order)
+		}
 	}
 
+	offset := (page - 1) * pageSize
+	err = db.Offset(offset).Limit(pageSize).Find(models).Error
+
 	return &PaginatedResult{
 		Results:    models,
 		Count:      count,
@@ -170,12 +199,12 @@ func (o *orm) Create(model any) []*response.ErrorResponse {
 		}
 		return respErrors
 	}
-	err := o.DB.Create(model).Error
+	err := o.db().Create(model).Error
 	if err != nil {
 		respErrors = append(respErrors, o.resp.New(err, model))
 		return respErrors
 	}
-	r := o.DB.Preload(clause.Associations).First(model).Error
+	r := o.db().Preload(clause.Associations).First(model).Error
 	if r != nil {
 		respErrors = append(respErrors, o.resp.New(r, model))
 		return respErrors
@@ -192,17 +221,17 @@ func (o *orm) Update(updates any, uuid string, where *WhereClause) (any, *respon
 		return nil, o.resp.New(gorm.ErrMissingWhereClause, updates)
 	}
 
-	err := o.DB.First(entity, uuid).Error
+	err := o.db().First(entity, uuid).Error
 	if err != nil {
 		return nil, o.resp.New(err, updates)
 	}
 
-	err = o.DB.Model(entity).Where(where.Query, where.Args...).Updates(updates).Error
+	err = o.db().Model(entity).Where(where.Query, where.Args...).Updates(updates).Error
 	if err != nil {
 		return nil, o.resp.New(err, updates)
 	}
 
-	err = o.DB.Preload(clause.Associations).Where(where.Query, where.Args...).First(entity).Error
+	err = o.db().Preload(clause.Associations).Where(where.Query, where.Args...).First(entity).Error
 	return entity, o.resp.New(err, updates)
 }
 
@@ -210,7 +239,11 @@ func (o *orm) Delete(model any, where *WhereClause) (bool, *response.ErrorRespon
 	if where == nil {
 		return false, o.resp.New(gorm.ErrMissingWhereClause, model), 0
 	}
-	r := o.DB.Where(where.Query, where.Args...).Delete(model)
+	query := o.db()
+	if where.Hard && isSoftDeletable(model) {
+		query = query.Unscoped()
+	}
+	r := query.Where(where.Query, where.Args...).Delete(model)
 	if r.Error != nil {
 		return false, o.resp.ErrorDeletion(r.Error, model), 0
 	} else if r.RowsAffected == 0 {
@@ -224,7 +257,7 @@ func (o *orm) Delete(model any, where *WhereClause) (bool, *response.ErrorRespon
 
 func (o *orm) BulkCreate(models []interface{}) []*response.ErrorResponse {
 	var respErrors []*response.ErrorResponse
-	tx := o.DB.Begin()
+	tx, ownTx := o.beginOrJoin()
 	for _, model := range models {
 		if err := o.validate.Struct(model); err != nil {
 			respErrors = append(respErrors, o.resp.New(err, model))
@@ -232,12 +265,16 @@ func (o *orm) BulkCreate(models []interface{}) []*response.ErrorResponse {
 		}
 		if err := tx.Create(model).Error; err != nil {
 			respErrors = append(respErrors, o.resp.New(err, model))
-			tx.Rollback()
+			if ownTx {
+				tx.Rollback()
+			}
 			return respErrors
 		}
 	}
-	if err := tx.Commit().Error; err != nil {
-		respErrors = append(respErrors, o.resp.New(err, nil))
+	if ownTx {
+		if err := tx.Commit().Error; err != nil {
+			respErrors = append(respErrors, o.resp.New(err, nil))
+		}
 	}
 	return respErrors
 }
@@ -248,19 +285,23 @@ func (o *orm) BulkUpdate(models []interface{}, uuids []string, where *WhereClaus
 		return append(respErrors, o.resp.New(errors.New("mismatch in models and uuids length"), nil))
 	}
 
-	tx := o.DB.Begin()
+	tx, ownTx := o.beginOrJoin()
 	for i, model := range models {
 		if where == nil {
 			return append(respErrors, o.resp.New(errNoWhereClause, nil))
 		}
 		if err := tx.Model(model).Where("uuid = ?", uuids[i]).Where(where.Query, where.Args...).Updates(model).Error; err != nil {
 			respErrors = append(respErrors, o.resp.New(err, model))
-			tx.Rollback()
+			if ownTx {
+				tx.Rollback()
+			}
 			return respErrors
 		}
 	}
-	if err := tx.Commit().Error; err != nil {
-		respErrors = append(respErrors, o.resp.New(err, nil))
+	if ownTx {
+		if err := tx.Commit().Error; err != nil {
+			respErrors = append(respErrors, o.resp.New(err, nil))
+		}
 	}
 	return respErrors
 }
@@ -271,23 +312,27 @@ func (o *orm) BulkDelete(modelType any, uuids []string, where *WhereClause) []*r
 		return append(respErrors, o.resp.New(errNoWhereClause, nil))
 	}
 
-	tx := o.DB.Begin()
+	tx, ownTx := o.beginOrJoin()
 	for _, uuid := range uuids {
 		entity := GetType(modelType)
 		if err := tx.Where("uuid = ?", uuid).Where(where.Query, where.Args...).Delete(entity).Error; err != nil {
 			respErrors = append(respErrors, o.resp.New(err, entity))
-			tx.Rollback()
+			if ownTx {
+				tx.Rollback()
+			}
 			return respErrors
 		}
 	}
-	if err := tx.Commit().Error; err != nil {
-		respErrors = append(respErrors, o.resp.New(err, nil))
+	if ownTx {
+		if err := tx.Commit().Error; err != nil {
+			respErrors = append(respErrors, o.resp.New(err, nil))
+		}
 	}
 	return respErrors
 }
 
 func (o *orm) GetDB() *gorm.DB {
-	return o.DB
+	return o.db()
 }
 
 func (o *orm) Migrate(models ...interface{}) error {
@@ -0,0 +1,298 @@
+package orm
+
+import (
+	"errors"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"gorm.io/gorm"
+)
+
+// BulkOptions controls the partial-success bulk operations (BulkCreateAny,
+// BulkUpdateAny, BulkDeleteAny). When ContinueOnError is false the whole
+// call runs in one transaction regardless of BatchSize, matching their
+// all-or-nothing counterparts (BulkCreate, BulkUpdate, BulkDelete): a
+// failure in any chunk rolls back every chunk, not just the one it
+// occurred in. When ContinueOnError is true, BatchSize rows commit per
+// chunk independently, and a failing row is rolled back to a savepoint and
+// recorded in BulkResult.Errors instead of aborting the chunk.
+type BulkOptions struct {
+	ContinueOnError bool
+	BatchSize       int // rows per chunk (each row gets its own savepoint); defaults to 1 when <= 0
+}
+
+// BulkItemError describes a single row that failed during a partial-success
+// bulk operation.
+type BulkItemError struct {
+	Index int
+	Model interface{}
+	Err   *response.ErrorResponse
+}
+
+// BulkResult reports row-level outcomes for a partial-success bulk
+// operation.
+type BulkResult struct {
+	SuccessCount int
+	FailCount    int
+	Errors       []BulkItemError
+}
+
+func batchSize(opts BulkOptions) int {
+	if opts.BatchSize <= 0 {
+		return 1
+	}
+	return opts.BatchSize
+}
+
+// BulkCreateAny creates models in chunks of opts.BatchSize. When
+// opts.ContinueOnError is set, each chunk commits independently and a
+// failing row is rolled back to a savepoint and recorded in
+// BulkResult.Errors instead of aborting the whole chunk. When
+// opts.ContinueOnError is false, the entire call runs in a single
+// transaction spanning every chunk, so a failure partway through leaves no
+// chunk committed - matching BulkCreate's all-or-nothing guarantee.
+func (o *orm) BulkCreateAny(models []interface{}, opts BulkOptions) *BulkResult {
+	result := &BulkResult{}
+	size := batchSize(opts)
+
+	var outerTx *gorm.DB
+	var ownOuterTx bool
+	if !opts.ContinueOnError {
+		outerTx, ownOuterTx = o.beginOrJoin()
+	}
+
+	for start := 0; start < len(models); start += size {
+		end := start + size
+		if end > len(models) {
+			end = len(models)
+		}
+		chunk := models[start:end]
+
+		tx, ownTx := outerTx, ownOuterTx
+		if tx == nil {
+			tx, ownTx = o.beginOrJoin()
+		}
+		chunkSuccess := 0
+		for i, model := range chunk {
+			index := start + i
+
+			tx.SavePoint("bulk_row")
+			if err := o.validate.Struct(model); err != nil {
+				result.FailCount++
+				result.Errors = append(result.Errors, BulkItemError{Index: index, Model: model, Err: o.resp.New(err, model)})
+				tx.RollbackTo("bulk_row")
+				if !opts.ContinueOnError {
+					if ownTx {
+						tx.Rollback()
+						result.FailCount += result.SuccessCount
+						result.SuccessCount = 0
+					}
+					return result
+				}
+				continue
+			}
+
+			if err := tx.Create(model).Error; err != nil {
+				result.FailCount++
+				result.Errors = append(result.Errors, BulkItemError{Index: index, Model: model, Err: o.resp.New(err, model)})
+				tx.RollbackTo("bulk_row")
+				if !opts.ContinueOnError {
+					if ownTx {
+						tx.Rollback()
+						result.FailCount += result.SuccessCount
+						result.SuccessCount = 0
+					}
+					return result
+				}
+				continue
+			}
+
+			chunkSuccess++
+		}
+
+		if outerTx == nil && ownTx {
+			if err := tx.Commit().Error; err != nil {
+				result.FailCount += chunkSuccess
+				result.Errors = append(result.Errors, BulkItemError{Index: start, Err: o.resp.New(err, nil)})
+				if !opts.ContinueOnError {
+					return result
+				}
+				continue
+			}
+		}
+
+		result.SuccessCount += chunkSuccess
+	}
+
+	if outerTx != nil && ownOuterTx {
+		if err := outerTx.Commit().Error; err != nil {
+			result.FailCount += result.SuccessCount
+			result.SuccessCount = 0
+			result.Errors = append(result.Errors, BulkItemError{Err: o.resp.New(err, nil)})
+		}
+	}
+
+	return result
+}
+
+// BulkUpdateAny is the partial-success counterpart of BulkUpdate. When
+// opts.ContinueOnError is set, each chunk of opts.BatchSize rows commits
+// independently and a failing row is recorded in BulkResult.Errors rather
+// than rolling back the whole batch. When opts.ContinueOnError is false,
+// the entire call runs in a single transaction spanning every chunk, so a
+// failure partway through leaves no chunk committed - matching
+// BulkUpdate's all-or-nothing guarantee.
+func (o *orm) BulkUpdateAny(models []interface{}, uuids []string, where *WhereClause, opts BulkOptions) *BulkResult {
+	result := &BulkResult{}
+	if len(models) != len(uuids) {
+		result.FailCount = len(models)
+		result.Errors = append(result.Errors, BulkItemError{Index: -1, Err: o.resp.New(errors.New("mismatch in models and uuids length"), nil)})
+		return result
+	}
+	if where == nil {
+		result.FailCount = len(models)
+		result.Errors = append(result.Errors, BulkItemError{Index: -1, Err: o.resp.New(errNoWhereClause, nil)})
+		return result
+	}
+
+	var outerTx *gorm.DB
+	var ownOuterTx bool
+	if !opts.ContinueOnError {
+		outerTx, ownOuterTx = o.beginOrJoin()
+	}
+
+	size := batchSize(opts)
+	for start := 0; start < len(models); start += size {
+		end := start + size
+		if end > len(models) {
+			end = len(models)
+		}
+
+		tx, ownTx := outerTx, ownOuterTx
+		if tx == nil {
+			tx, ownTx = o.beginOrJoin()
+		}
+		chunkSuccess := 0
+		for i := start; i < end; i++ {
+			model := models[i]
+
+			tx.SavePoint("bulk_row")
+			if err := tx.Model(model).Where("uuid = ?", uuids[i]).Where(where.Query, where.Args...).Updates(model).Error; err != nil {
+				result.FailCount++
+				result.Errors = append(result.Errors, BulkItemError{Index: i, Model: model, Err: o.resp.New(err, model)})
+				tx.RollbackTo("bulk_row")
+				if !opts.ContinueOnError {
+					if ownTx {
+						tx.Rollback()
+						result.FailCount += result.SuccessCount
+						result.SuccessCount = 0
+					}
+					return result
+				}
+				continue
+			}
+
+			chunkSuccess++
+		}
+
+		if outerTx == nil && ownTx {
+			if err := tx.Commit().Error; err != nil {
+				result.FailCount += chunkSuccess
+				result.Errors = append(result.Errors, BulkItemError{Index: start, Err: o.resp.New(err, nil)})
+				if !opts.ContinueOnError {
+					return result
+				}
+				continue
+			}
+		}
+
+		result.SuccessCount += chunkSuccess
+	}
+
+	if outerTx != nil && ownOuterTx {
+		if err := outerTx.Commit().Error; err != nil {
+			result.FailCount += result.SuccessCount
+			result.SuccessCount = 0
+			result.Errors = append(result.Errors, BulkItemError{Err: o.resp.New(err, nil)})
+		}
+	}
+
+	return result
+}
+
+// BulkDeleteAny is the partial-success counterpart of BulkDelete. When
+// opts.ContinueOnError is false, the entire call runs in a single
+// transaction spanning every chunk, so a failure partway through leaves no
+// chunk committed - matching BulkDelete's all-or-nothing guarantee.
+func (o *orm) BulkDeleteAny(modelType any, uuids []string, where *WhereClause, opts BulkOptions) *BulkResult {
+	result := &BulkResult{}
+	if where == nil {
+		result.FailCount = len(uuids)
+		result.Errors = append(result.Errors, BulkItemError{Index: -1, Err: o.resp.New(errNoWhereClause, nil)})
+		return result
+	}
+
+	var outerTx *gorm.DB
+	var ownOuterTx bool
+	if !opts.ContinueOnError {
+		outerTx, ownOuterTx = o.beginOrJoin()
+	}
+
+	size := batchSize(opts)
+	for start := 0; start < len(uuids); start += size {
+		end := start + size
+		if end > len(uuids) {
+			end = len(uuids)
+		}
+
+		tx, ownTx := outerTx, ownOuterTx
+		if tx == nil {
+			tx, ownTx = o.beginOrJoin()
+		}
+		chunkSuccess := 0
+		for i := start; i < end; i++ {
+			uuid := uuids[i]
+			entity := GetType(modelType)
+
+			tx.SavePoint("bulk_row")
+			if err := tx.Where("uuid = ?", uuid).Where(where.Query, where.Args...).Delete(entity).Error; err != nil {
+				result.FailCount++
+				result.Errors = append(result.Errors, BulkItemError{Index: i, Model: entity, Err: o.resp.New(err, entity)})
+				tx.RollbackTo("bulk_row")
+				if !opts.ContinueOnError {
+					if ownTx {
+						tx.Rollback()
+						result.FailCount += result.SuccessCount
+						result.SuccessCount = 0
+					}
+					return result
+				}
+				continue
+			}
+
+			chunkSuccess++
+		}
+
+		if outerTx == nil && ownTx {
+			if err := tx.Commit().Error; err != nil {
+				result.FailCount += chunkSuccess
+				result.Errors = append(result.Errors, BulkItemError{Index: start, Err: o.resp.New(err, nil)})
+				if !opts.ContinueOnError {
+					return result
+				}
+				continue
+			}
+		}
+
+		result.SuccessCount += chunkSuccess
+	}
+
+	if outerTx != nil && ownOuterTx {
+		if err := outerTx.Commit().Error; err != nil {
+			result.FailCount += result.SuccessCount
+			result.SuccessCount = 0
+			result.Errors = append(result.Errors, BulkItemError{Err: o.resp.New(err, nil)})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type bulkTestRow struct {
+	gorm.Model
+	UUID string `gorm:"uniqueIndex" validate:"required"`
+	Name string `validate:"required"`
+}
+
+func newBulkTestORM(t *testing.T) *orm {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("underlying sql.DB: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1) // shared in-memory db only persists while a connection is open
+	if err := db.AutoMigrate(&bulkTestRow{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+	return &orm{DB: db, resp: &response.ErrorResponse{}, validate: validator.New()}
+}
+
+// TestBulkCreateAny_AllOrNothing verifies that when ContinueOnError is
+// false, a failure partway through a multi-chunk call leaves no row
+// committed: earlier chunks share the outer transaction, so the later
+// chunk's rollback undoes them too, and BulkResult must report that rather
+// than counting them as successes.
+func TestBulkCreateAny_AllOrNothing(t *testing.T) {
+	o := newBulkTestORM(t)
+
+	rows := []interface{}{
+		&bulkTestRow{UUID: "a", Name: "A"},
+		&bulkTestRow{UUID: "b", Name: "B"},
+		&bulkTestRow{UUID: "c", Name: ""}, // fails validation: Name required
+		&bulkTestRow{UUID: "d", Name: "D"},
+	}
+
+	result := o.BulkCreateAny(rows, BulkOptions{ContinueOnError: false, BatchSize: 2})
+
+	if result.SuccessCount != 0 {
+		t.Errorf("SuccessCount = %d, want 0 (whole call should roll back)", result.SuccessCount)
+	}
+	if result.FailCount == 0 {
+		t.Errorf("FailCount = 0, want > 0")
+	}
+
+	var count int64
+	if err := o.DB.Model(&bulkTestRow{}).Count(&count).Error; err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("rows persisted = %d, want 0", count)
+	}
+}
+
+// TestBulkCreateAny_ContinueOnError verifies that with ContinueOnError
+// set, each chunk commits independently: only the failing row is excluded
+// from BulkResult.SuccessCount, and rows from chunks before and after it
+// persist.
+func TestBulkCreateAny_ContinueOnError(t *testing.T) {
+	o := newBulkTestORM(t)
+
+	rows := []interface{}{
+		&bulkTestRow{UUID: "a", Name: "A"},
+		&bulkTestRow{UUID: "b", Name: ""}, // fails validation
+		&bulkTestRow{UUID: "c", Name: "C"},
+	}
+
+	result := o.BulkCreateAny(rows, BulkOptions{ContinueOnError: true, BatchSize: 1})
+
+	if result.SuccessCount != 2 {
+		t.Errorf("SuccessCount = %d, want 2", result.SuccessCount)
+	}
+	if result.FailCount != 1 {
+		t.Errorf("FailCount = %d, want 1", result.FailCount)
+	}
+
+	var count int64
+	if err := o.DB.Model(&bulkTestRow{}).Count(&count).Error; err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("rows persisted = %d, want 2", count)
+	}
+}
@@ -0,0 +1,217 @@
+package orm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"gorm.io/gorm"
+)
+
+// CursorResult holds the result of a keyset-paginated query.
+type CursorResult struct {
+	Results    any    `json:"results"`    // Pointer to a slice of models
+	NextCursor string `json:"nextCursor"` // Opaque token for the next page, empty when exhausted
+	HasNext    bool   `json:"hasNext"`    // Indicates if there are more pages
+}
+
+// cursorToken is the signed payload encoded in a cursor string. Values
+// holds the last row's sort-key values as raw JSON so the HMAC is taken
+// over a stable byte sequence rather than a re-marshaled struct.
+type cursorToken struct {
+	Values json.RawMessage `json:"values"`
+	Sig    string          `json:"sig"`
+}
+
+func cursorSig(secret []byte, values json.RawMessage) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(values)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func encodeCursor(secret []byte, values []interface{}) (string, error) {
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	token := cursorToken{Values: valuesJSON, Sig: cursorSig(secret, valuesJSON)}
+	tokenJSON, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(tokenJSON), nil
+}
+
+func decodeCursor(secret []byte, cursor string) ([]interface{}, error) {
+	tokenJSON, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	var token cursorToken
+	if err := json.Unmarshal(tokenJSON, &token); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	expected := cursorSig(secret, token.Values)
+	if !hmac.Equal([]byte(expected), []byte(token.Sig)) {
+		return nil, errors.New("cursor signature mismatch")
+	}
+
+	var values []interface{}
+	if err := json.Unmarshal(token.Values, &values); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return values, nil
+}
+
+// cursorSortFields resolves the ordered sort columns used for keyset
+// pagination: where.Sort (validated against the model's allow-list), or
+// "uuid" ascending when none is set, since uuid is this package's
+// convention for a row's primary lookup key. models is the *[]T passed
+// into GetAllCursor; sortAllowList resolves Sortable against its element
+// type, so a model-level allow-list is honored here too.
+func cursorSortFields(models any, where *WhereClause) ([]sortField, error) {
+	fields, err := parseSort(where.Sort, sortAllowList(models, where))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		fields = []sortField{{Column: "uuid", Desc: false}}
+	}
+	return fields, nil
+}
+
+// cursorPredicate builds the "WHERE (k1,k2,...) > (v1,v2,...)" keyset
+// predicate as an OR-chain of range comparisons, which is required (rather
+// than a single SQL tuple comparison) once sort columns mix ASC and DESC
+// directions.
+func cursorPredicate(fields []sortField, values []interface{}) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	for i, f := range fields {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = ?", fields[j].Column))
+			args = append(args, values[j])
+		}
+
+		op := ">"
+		if f.Desc {
+			op = "<"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s ?", f.Column, op))
+		args = append(args, values[i])
+
+		clauses = append(clauses, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return strings.Join(clauses, " OR "), args
+}
+
+// GetAllCursor implements keyset pagination: the sort key(s) come from
+// where.Sort (or default to uuid), the token is a base64-encoded, HMAC
+// signed representation of the last row's sort-key values, and the next
+// query adds a keyset predicate (see cursorPredicate) in the correct
+// direction for each sort column. Rows with a NULL sort key are excluded since they
+// have no deterministic position in the keyset order. An empty cursor
+// returns the first page.
+func (o *orm) GetAllCursor(models any, cursor string, pageSize int, where *WhereClause) (*CursorResult, *response.ErrorResponse) {
+	if where == nil {
+		where = &WhereClause{}
+	}
+
+	fields, err := cursorSortFields(models, where)
+	if err != nil {
+		return nil, o.resp.New(err, models)
+	}
+
+	// base carries only the row-filtering clauses, so it can be reused for
+	// the lastRow lookup below (which scans into a map[string]interface{}
+	// and would break if a Preload callback ran against it, since Preload
+	// expects a struct/slice-of-struct destination). Preloads are added
+	// separately, only for the query that populates models.
+	base := o.db().Model(models)
+	if len(where.Select) > 0 {
+		base = base.Select(where.Select)
+	}
+	base = applyTrashedScope(base, models, where)
+	if where.Query != "" {
+		base = base.Where(where.Query, where.Args...)
+	}
+	for _, f := range fields {
+		base = base.Where(fmt.Sprintf("%s IS NOT NULL", f.Column))
+	}
+
+	if cursor != "" {
+		values, err := decodeCursor(o.cursorSecret, cursor)
+		if err != nil {
+			return nil, o.resp.New(err, models)
+		}
+		if len(values) != len(fields) {
+			return nil, o.resp.New(errors.New("cursor does not match sort columns"), models)
+		}
+		predicate, args := cursorPredicate(fields, values)
+		base = base.Where(predicate, args...)
+	}
+
+	order := make([]string, 0, len(fields))
+	for _, f := range fields {
+		direction := "ASC"
+		if f.Desc {
+			direction = "DESC"
+		}
+		order = append(order, fmt.Sprintf("%s %s", f.Column, direction))
+	}
+	orderExpr := strings.Join(order, ", ")
+
+	// A new session makes each chain below start from a copy of base's
+	// statement, so the Preloads, Order and Limit added for the first Find
+	// do not carry over into the lastRow lookup.
+	base = base.Session(&gorm.Session{})
+	find := applyPreloads(base, where.Preloads)
+	if result := find.Order(orderExpr).Limit(pageSize + 1).Find(models); result.Error != nil {
+		return nil, o.resp.New(result.Error, models)
+	}
+
+	count := reflect.ValueOf(models).Elem().Len()
+	hasNext := count > pageSize
+	if hasNext {
+		rows := reflect.ValueOf(models).Elem()
+		rows.Set(rows.Slice(0, pageSize))
+	}
+
+	cursorResult := &CursorResult{Results: models, HasNext: hasNext}
+	if hasNext {
+		columns := make([]string, len(fields))
+		for i, f := range fields {
+			columns[i] = f.Column
+		}
+
+		var lastRow map[string]interface{}
+		if err := base.Order(orderExpr).Select(columns).Offset(pageSize - 1).Limit(1).Find(&lastRow).Error; err != nil {
+			return nil, o.resp.New(err, models)
+		}
+
+		values := make([]interface{}, len(columns))
+		for i, col := range columns {
+			values[i] = lastRow[col]
+		}
+
+		next, err := encodeCursor(o.cursorSecret, values)
+		if err != nil {
+			return nil, o.resp.New(err, models)
+		}
+		cursorResult.NextCursor = next
+	}
+
+	return cursorResult, nil
+}
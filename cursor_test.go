@@ -0,0 +1,114 @@
+package orm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type cursorTestParent struct {
+	gorm.Model
+	UUID     string `gorm:"uniqueIndex"`
+	Name     string
+	Children []cursorTestChild
+}
+
+type cursorTestChild struct {
+	gorm.Model
+	ParentID uint
+	Name     string
+}
+
+func newCursorTestORM(t *testing.T) *orm {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("underlying sql.DB: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1) // shared in-memory db only persists while a connection is open
+
+	if err := db.AutoMigrate(&cursorTestParent{}, &cursorTestChild{}); err != nil {
+		t.Fatalf("automigrate: %v", err)
+	}
+
+	uuids := []string{"p0", "p1", "p2", "p3", "p4"}
+	for _, uuid := range uuids {
+		p := &cursorTestParent{UUID: uuid, Name: "parent-" + uuid}
+		if err := db.Create(p).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+
+	return &orm{
+		DB:           db,
+		resp:         &response.ErrorResponse{},
+		validate:     validator.New(),
+		cursorSecret: []byte("test-cursor-secret-0123456789ab"),
+	}
+}
+
+// TestGetAllCursor_TamperedSignatureRejected verifies decodeCursor's HMAC
+// check rejects a cursor whose payload was modified after issuance.
+func TestGetAllCursor_TamperedSignatureRejected(t *testing.T) {
+	o := newCursorTestORM(t)
+
+	var page []cursorTestParent
+	result, errResp := o.GetAllCursor(&page, "", 2, &WhereClause{Sort: "uuid", SortableFields: []string{"uuid"}})
+	if errResp != nil {
+		t.Fatalf("first page: %v", errResp)
+	}
+	if !result.HasNext || result.NextCursor == "" {
+		t.Fatalf("expected a next cursor")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(result.NextCursor)
+	if err != nil {
+		t.Fatalf("decode cursor: %v", err)
+	}
+	var token cursorToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		t.Fatalf("unmarshal cursor: %v", err)
+	}
+	token.Values = json.RawMessage(`["p0"]`) // keep the old signature
+	forged, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("marshal cursor: %v", err)
+	}
+	tampered := base64.RawURLEncoding.EncodeToString(forged)
+
+	var next []cursorTestParent
+	if _, errResp := o.GetAllCursor(&next, tampered, 2, &WhereClause{Sort: "uuid", SortableFields: []string{"uuid"}}); errResp == nil {
+		t.Errorf("expected tampered cursor to be rejected")
+	}
+}
+
+// TestGetAllCursor_PreloadsDontBreakNextCursor guards against the lastRow
+// lookup used to build the next-page cursor running with Preload clauses
+// still attached: Preload expects a struct/slice destination, but lastRow
+// is a map, so a shared query would error out on exactly this path.
+func TestGetAllCursor_PreloadsDontBreakNextCursor(t *testing.T) {
+	o := newCursorTestORM(t)
+
+	var page []cursorTestParent
+	where := &WhereClause{
+		Sort:           "uuid",
+		SortableFields: []string{"uuid"},
+		Preloads:       []PreloadSpec{{Association: "Children"}},
+	}
+	result, errResp := o.GetAllCursor(&page, "", 2, where)
+	if errResp != nil {
+		t.Fatalf("GetAllCursor with Preloads: %v", errResp)
+	}
+	if !result.HasNext || result.NextCursor == "" {
+		t.Errorf("expected a next cursor with Preloads set")
+	}
+}
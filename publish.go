@@ -0,0 +1,153 @@
+package orm
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// Storage is a pluggable write target for published pages, e.g. a local
+// directory, S3 bucket, or CDN origin.
+type Storage interface {
+	Put(path string, content []byte) error
+	Delete(path string) error
+	List(prefix string) ([]string, error)
+}
+
+// ListPublisher supplies the URLs and rendering used to turn a paginated
+// query into a set of static pages.
+type ListPublisher interface {
+	PageURL(pageNum int) string
+	IndexURL() string
+	Render(items any, pageNum, totalPages int) ([]byte, error)
+}
+
+// PublishOptions configures PublishList.
+type PublishOptions struct {
+	PageSize int     // rows per page; defaults to 50 when <= 0
+	Storage  Storage // required
+}
+
+// PublishList paginates through model/where in fixed page sizes, renders
+// each page via pub.Render and writes it to opts.Storage under
+// pub.PageURL(pageNum). After writing the current pages it lists
+// opts.Storage under the pages' common prefix and deletes any page left
+// over from a previous, larger publish (pub.IndexURL() is never deleted,
+// since PublishList doesn't write it itself).
+func (o *orm) PublishList(model any, where *WhereClause, pub ListPublisher, opts PublishOptions) error {
+	if opts.Storage == nil {
+		return errors.New("publish: storage is required")
+	}
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	sliceType := reflect.SliceOf(reflect.TypeOf(model))
+
+	countQuery := o.db().Model(model)
+	if where != nil {
+		countQuery = applyTrashedScope(countQuery, model, where)
+		if where.Query != "" {
+			countQuery = countQuery.Where(where.Query, where.Args...)
+		}
+	}
+	var count int64
+	if err := countQuery.Count(&count).Error; err != nil {
+		return err
+	}
+
+	totalPages := int(count) / pageSize
+	if int(count)%pageSize > 0 {
+		totalPages++
+	}
+
+	published := make(map[string]bool, totalPages)
+	for page := 1; page <= totalPages; page++ {
+		items := reflect.New(sliceType).Interface()
+
+		query := o.db().Model(model)
+		if where != nil {
+			query = applyTrashedScope(query, model, where)
+			if where.Query != "" {
+				query = query.Where(where.Query, where.Args...)
+			}
+			order, err := orderClause(model, where)
+			if err != nil {
+				return err
+			}
+			if order != "" {
+				query = query.Order(order)
+			}
+		}
+
+		offset := (page - 1) * pageSize
+		if err := query.Offset(offset).Limit(pageSize).Find(items).Error; err != nil {
+			return err
+		}
+
+		content, err := pub.Render(items, page, totalPages)
+		if err != nil {
+			return err
+		}
+
+		path := pub.PageURL(page)
+		if err := opts.Storage.Put(path, content); err != nil {
+			return err
+		}
+		published[path] = true
+	}
+
+	existing, err := opts.Storage.List(publishPrefix(pub.PageURL(1)))
+	if err != nil {
+		return err
+	}
+	for _, path := range existing {
+		if path == pub.IndexURL() || published[path] {
+			continue
+		}
+		if err := opts.Storage.Delete(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishPrefix derives the List() prefix shared by all pages from the
+// first page's URL, i.e. everything up to and including its final slash.
+func publishPrefix(firstPageURL string) string {
+	idx := strings.LastIndex(firstPageURL, "/")
+	if idx < 0 {
+		return ""
+	}
+	return firstPageURL[:idx+1]
+}
+
+// NeedsRepublish reports whether a change to the item at changedItemIndex
+// requires rerunning the publish at all: false only for an index outside
+// [0, totalItems), since every in-range index belongs to some published
+// page. Callers republishing page-by-page and wanting to skip pages the
+// change didn't touch should use PageNeedsRepublish instead, which checks
+// a single page's row range.
+func NeedsRepublish(totalItems, pageSize, changedItemIndex int) bool {
+	if pageSize <= 0 {
+		return true
+	}
+	return changedItemIndex >= 0 && changedItemIndex < totalItems
+}
+
+// PageNeedsRepublish reports whether pageNum (1-indexed, as passed to
+// pub.PageURL) needs to be regenerated after a change to the item at
+// changedItemIndex: only the page whose row range actually contains that
+// index does. Callers republishing page-by-page can use this to skip
+// every page the change didn't touch instead of rerunning PublishList in
+// full.
+func PageNeedsRepublish(pageSize, changedItemIndex, pageNum int) bool {
+	if pageSize <= 0 || changedItemIndex < 0 || pageNum <= 0 {
+		return true
+	}
+	pageStart := (pageNum - 1) * pageSize
+	pageEnd := pageStart + pageSize
+	return changedItemIndex >= pageStart && changedItemIndex < pageEnd
+}
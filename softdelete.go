@@ -0,0 +1,75 @@
+package orm
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"gorm.io/gorm"
+)
+
+// SoftDeletable is implemented by models that want to opt in or out of
+// soft-delete detection explicitly, overriding the default of looking for
+// an embedded gorm.DeletedAt field.
+type SoftDeletable interface {
+	SoftDeletable() bool
+}
+
+var deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+
+// isSoftDeletable reports whether model should be treated as soft
+// deletable: either it (or its slice element type, for *[]T models)
+// implements SoftDeletable, or it has a field of type gorm.DeletedAt,
+// including one embedded inside an anonymous field such as gorm.Model.
+func isSoftDeletable(model any) bool {
+	instance := modelInstance(model)
+	if sd, ok := instance.(SoftDeletable); ok {
+		return sd.SoftDeletable()
+	}
+
+	t := reflect.TypeOf(instance)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return hasDeletedAtField(t)
+}
+
+// hasDeletedAtField reports whether t has a field of type gorm.DeletedAt,
+// recursing into anonymous (embedded) struct fields such as gorm.Model.
+func hasDeletedAtField(t reflect.Type) bool {
+	if t == nil || t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type == deletedAtType {
+			return true
+		}
+		if field.Anonymous && hasDeletedAtField(field.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// Restore clears deleted_at on rows matching where, undoing a prior soft
+// delete. For models that isSoftDeletable rejects it returns an error and
+// touches no rows.
+func (o *orm) Restore(model any, where *WhereClause) (int64, *response.ErrorResponse) {
+	if where == nil {
+		return 0, o.resp.New(gorm.ErrMissingWhereClause, model)
+	}
+	if !isSoftDeletable(model) {
+		return 0, o.resp.New(errors.New("model is not soft deletable"), model)
+	}
+
+	r := o.db().Unscoped().Model(model).
+		Where(where.Query, where.Args...).
+		Where("deleted_at IS NOT NULL").
+		Update("deleted_at", nil)
+	if r.Error != nil {
+		return 0, o.resp.New(r.Error, model)
+	}
+	return r.RowsAffected, nil
+}
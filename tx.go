@@ -0,0 +1,91 @@
+package orm
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/NubeIO/rubix-rx/server/database/response"
+	"gorm.io/gorm"
+)
+
+// savepointSeq generates unique savepoint names for nested Transaction
+// calls; shared package-wide since uniqueness, not ordering, is all that's
+// required of it.
+var savepointSeq int64
+
+func nextSavepointName() string {
+	return fmt.Sprintf("tx_sp_%d", atomic.AddInt64(&savepointSeq, 1))
+}
+
+// db returns the handle every method should issue queries against: the
+// active transaction when one has been set via WithTx/Transaction, or the
+// base connection otherwise.
+func (o *orm) db() *gorm.DB {
+	if o.tx != nil {
+		return o.tx
+	}
+	return o.DB
+}
+
+// beginOrJoin returns a handle the caller can run a multi-statement bulk
+// operation against: a fresh transaction it owns (and must commit/rollback
+// itself), or the already-active transaction when one is in scope, in
+// which case the second return value is false and the caller must not
+// commit or roll it back.
+func (o *orm) beginOrJoin() (*gorm.DB, bool) {
+	if o.tx != nil {
+		return o.tx, false
+	}
+	return o.DB.Begin(), true
+}
+
+// WithTx returns an ORM whose methods run against tx instead of the base
+// connection, for callers that already manage their own transaction.
+func (o *orm) WithTx(tx *gorm.DB) ORM {
+	return &orm{
+		DB:           o.DB,
+		resp:         o.resp,
+		validate:     o.validate,
+		cursorSecret: o.cursorSecret,
+		tx:           tx,
+	}
+}
+
+// Transaction begins a transaction, invokes fn with an ORM scoped to it,
+// and commits or rolls back based on the returned error. Nested calls (fn
+// calling tx.Transaction again) do not start a second database
+// transaction - o.tx.Begin() on a connection already inside a transaction
+// fails with gorm.ErrInvalidTransaction, since *sql.Tx doesn't support
+// beginning another transaction on top of itself. Instead, a nested call
+// takes a savepoint on the active transaction and rolls back to it on
+// error, leaving the outer transaction free to continue or itself roll
+// back.
+func (o *orm) Transaction(fn func(tx ORM) error) *response.ErrorResponse {
+	if o.tx != nil {
+		name := nextSavepointName()
+		if err := o.tx.SavePoint(name).Error; err != nil {
+			return o.resp.New(err, nil)
+		}
+
+		if err := fn(o); err != nil {
+			o.tx.RollbackTo(name)
+			return o.resp.New(err, nil)
+		}
+		return nil
+	}
+
+	txDB := o.DB.Begin()
+	if txDB.Error != nil {
+		return o.resp.New(txDB.Error, nil)
+	}
+
+	if err := fn(o.WithTx(txDB)); err != nil {
+		txDB.Rollback()
+		return o.resp.New(err, nil)
+	}
+
+	if err := txDB.Commit().Error; err != nil {
+		return o.resp.New(err, nil)
+	}
+	return nil
+}
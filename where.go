@@ -0,0 +1,217 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// WhereClause describes the filtering, ordering, projection and
+// eager-loading options applied to a query. Query/Args are passed straight
+// through to GORM's Where(), so callers are expected to use parameterized
+// placeholders.
+type WhereClause struct {
+	Query  string
+	Args   []interface{}
+	Limit  int
+	Offset int
+
+	// Select restricts the columns projected by Get/All. Leave empty to
+	// select all columns.
+	Select []string
+
+	// Preloads lists the associations to eager-load, each optionally
+	// constrained to a subset of columns and/or a nested WhereClause.
+	Preloads []PreloadSpec
+
+	// OrderByASC/OrderByDESC order by a single column. Kept for backward
+	// compatibility; prefer Sort when it is set.
+	OrderByASC  string
+	OrderByDESC string
+
+	// Sort is a comma-separated list of columns defining ORDER BY
+	// precedence, e.g. "name,-created_at,+priority". A leading '-' means
+	// descending, '+' or no prefix means ascending.
+	Sort string
+
+	// SortableFields is the allow-list of columns Sort may reference. If
+	// the model being queried implements the Sortable interface, its
+	// Sortable() result is used instead and this field is ignored.
+	SortableFields []string
+
+	// IncludeTrashed includes soft-deleted rows alongside live ones.
+	// OnlyTrashed restricts the query to soft-deleted rows and implies
+	// IncludeTrashed. Both are no-ops against models that aren't soft
+	// deletable (see isSoftDeletable).
+	IncludeTrashed bool
+	OnlyTrashed    bool
+
+	// Hard makes Delete bypass soft-delete and permanently remove the
+	// row, for models that have a gorm.DeletedAt field.
+	Hard bool
+}
+
+// PreloadSpec constrains an eager-loaded association to a subset of
+// columns and/or rows.
+type PreloadSpec struct {
+	Association string
+	Columns     []string
+	Where       *WhereClause
+}
+
+// applyPreloads adds a Preload call for each PreloadSpec, projecting
+// Columns and applying Where on the association when set.
+func applyPreloads(query *gorm.DB, preloads []PreloadSpec) *gorm.DB {
+	for _, p := range preloads {
+		p := p
+		query = query.Preload(p.Association, func(db *gorm.DB) *gorm.DB {
+			scoped := db
+			if len(p.Columns) > 0 {
+				scoped = scoped.Select(p.Columns)
+			}
+			if p.Where != nil {
+				scoped = scoped.Where(p.Where.Query, p.Where.Args...)
+			}
+			return scoped
+		})
+	}
+	return query
+}
+
+// applyTrashedScope maps WhereClause.IncludeTrashed/OnlyTrashed onto
+// GORM's Unscoped() semantics: OnlyTrashed restricts the query to
+// soft-deleted rows, IncludeTrashed lifts GORM's default deleted_at
+// filter to return both, and neither leaves the default live-rows-only
+// behavior in place. model is checked against isSoftDeletable first,
+// since Unscoped().Where("deleted_at ...") against a model that has no
+// such column is a DB error, not a no-op.
+func applyTrashedScope(query *gorm.DB, model any, where *WhereClause) *gorm.DB {
+	if !where.OnlyTrashed && !where.IncludeTrashed {
+		return query
+	}
+	if !isSoftDeletable(model) {
+		return query
+	}
+	if where.OnlyTrashed {
+		return query.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+	return query.Unscoped()
+}
+
+// Sortable is implemented by models that want to supply their own
+// allow-list of sortable columns instead of relying on
+// WhereClause.SortableFields.
+type Sortable interface {
+	Sortable() []string
+}
+
+// sortField is a single parsed entry from WhereClause.Sort.
+type sortField struct {
+	Column string
+	Desc   bool
+}
+
+// parseSort parses a comma-separated Sort string into ordered sortFields,
+// validating each column against allowed. An empty Sort returns no fields
+// and no error.
+func parseSort(sort string, allowed []string) ([]sortField, error) {
+	if sort == "" {
+		return nil, nil
+	}
+
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, col := range allowed {
+		allowedSet[col] = true
+	}
+
+	parts := strings.Split(sort, ",")
+	fields := make([]sortField, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		desc := false
+		switch part[0] {
+		case '-':
+			desc = true
+			part = part[1:]
+		case '+':
+			part = part[1:]
+		}
+
+		if part == "" {
+			return nil, fmt.Errorf("invalid sort entry in %q", sort)
+		}
+		if !allowedSet[part] {
+			return nil, fmt.Errorf("column %q is not sortable", part)
+		}
+
+		fields = append(fields, sortField{Column: part, Desc: desc})
+	}
+
+	return fields, nil
+}
+
+// modelInstance resolves model to a value suitable for interface
+// assertions against its element type: a pointer-to-slice such as
+// *[]User (what All/GetAllPaginated/GetAllCursor pass in) is reduced to a
+// *User so Sortable (and similar per-model interfaces) implemented on the
+// element type is still found.
+func modelInstance(model any) any {
+	t := reflect.TypeOf(model)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Slice {
+		return model
+	}
+
+	elem := t.Elem().Elem()
+	if elem.Kind() == reflect.Ptr {
+		return reflect.New(elem.Elem()).Interface()
+	}
+	return reflect.New(elem).Interface()
+}
+
+// sortAllowList resolves the allow-list used to validate where.Sort: the
+// model's Sortable() result takes precedence over where.SortableFields.
+// model may be a single instance or a pointer to a slice, either way
+// Sortable is asserted against the element type via modelInstance.
+func sortAllowList(model any, where *WhereClause) []string {
+	if sortable, ok := modelInstance(model).(Sortable); ok {
+		return sortable.Sortable()
+	}
+	return where.SortableFields
+}
+
+// orderClause builds a single "col1 ASC, col2 DESC, ..." expression from
+// where.Sort, validated against the model's allow-list. It falls back to
+// OrderByASC/OrderByDESC when Sort is empty.
+func orderClause(model any, where *WhereClause) (string, error) {
+	fields, err := parseSort(where.Sort, sortAllowList(model, where))
+	if err != nil {
+		return "", err
+	}
+
+	if len(fields) == 0 {
+		var clauses []string
+		if where.OrderByASC != "" {
+			clauses = append(clauses, fmt.Sprintf("%s ASC", where.OrderByASC))
+		}
+		if where.OrderByDESC != "" {
+			clauses = append(clauses, fmt.Sprintf("%s DESC", where.OrderByDESC))
+		}
+		return strings.Join(clauses, ", "), nil
+	}
+
+	clauses := make([]string, 0, len(fields))
+	for _, f := range fields {
+		direction := "ASC"
+		if f.Desc {
+			direction = "DESC"
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s", f.Column, direction))
+	}
+	return strings.Join(clauses, ", "), nil
+}